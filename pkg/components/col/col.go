@@ -0,0 +1,114 @@
+// Package col provides the concrete core.Col implementation: a vertical
+// slice of a row holding one or more components.
+package col
+
+import (
+	"github.com/flanksource/maroto/v2/pkg/core"
+	"github.com/flanksource/maroto/v2/pkg/fpdf"
+	"github.com/flanksource/maroto/v2/pkg/props"
+)
+
+// Col is core.Col plus the presentation props this package's implementation
+// supports, so callers that built one with New can keep chaining without a
+// type assertion back to core.Col.
+type Col interface {
+	core.Col
+	WithFillColor(color props.Color) Col
+	WithLineStyle(style fpdf.LineStyle) Col
+}
+
+type col struct {
+	width      int
+	components []core.Component
+	layerID    *core.LayerID
+	fillColor  *props.Color
+	lineStyle  *fpdf.LineStyle
+}
+
+// New creates a Col with the given width (in the row's grid units) holding
+// the given components.
+func New(width int, components ...core.Component) Col {
+	return &col{width: width, components: components}
+}
+
+// WithLayer returns a copy of the col scoped to the given layer: its render
+// is wrapped in the PDF's "/OC BDC … EMC" marked-content sequence.
+func (c *col) WithLayer(id core.LayerID) core.Col {
+	clone := *c
+	clone.layerID = &id
+	return &clone
+}
+
+// WithFillColor returns a copy of the col that fills its background with
+// color (an RGB or, when color.SpotName is set, a spot color) before
+// rendering its components.
+func (c *col) WithFillColor(color props.Color) Col {
+	clone := *c
+	clone.fillColor = &color
+	return &clone
+}
+
+// WithLineStyle returns a copy of the col whose border/line draws use style
+// (dash pattern, cap, join) while rendering its components.
+func (c *col) WithLineStyle(style fpdf.LineStyle) Col {
+	clone := *c
+	clone.lineStyle = &style
+	return &clone
+}
+
+// Render draws every component in the col, applying the col's fill color
+// and line style (if any) and scoping them to the col's layer (if any), all
+// via fpdf.DrawingHelper.
+func (c *col) Render(provider core.Provider, cell core.Cell) {
+	dh := fpdf.NewDrawingHelper(provider)
+
+	renderComponents := func() {
+		if dh != nil && c.fillColor != nil {
+			applyFillColor(dh, *c.fillColor)
+			dh.DrawRect(cell.X, cell.Y, cell.Width, cell.Height, "F")
+		}
+		for _, component := range c.components {
+			component.Render(provider, cell)
+		}
+	}
+
+	withLineStyle := func(render func()) {
+		if dh == nil || c.lineStyle == nil {
+			render()
+			return
+		}
+		dh.SetDashPattern(c.lineStyle.DashArray, c.lineStyle.DashPhase)
+		if c.lineStyle.Cap != "" {
+			dh.SetLineCapStyle(c.lineStyle.Cap)
+		}
+		if c.lineStyle.Join != "" {
+			dh.SetLineJoinStyle(c.lineStyle.Join)
+		}
+		render()
+		dh.SetDashPattern(nil, 0)
+		dh.SetLineCapStyle(fpdf.LineCapButt)
+		dh.SetLineJoinStyle(fpdf.LineJoinMiter)
+	}
+
+	withLayer := func(render func()) {
+		if dh == nil || c.layerID == nil {
+			render()
+			return
+		}
+		dh.BeginLayer(*c.layerID)
+		render()
+		dh.EndLayer()
+	}
+
+	withLayer(func() { withLineStyle(renderComponents) })
+}
+
+// applyFillColor sets dh's fill color from color, preferring its spot color
+// when set.
+func applyFillColor(dh *fpdf.DrawingHelper, color props.Color) {
+	if color.IsSpot() {
+		dh.SetFillSpotColor(color.SpotName, color.Tint)
+		return
+	}
+	dh.SetFillColor(int(color.Red), int(color.Green), int(color.Blue))
+}
@@ -0,0 +1,116 @@
+// Package row provides the concrete core.Row implementation: a horizontal
+// band of the page made up of one or more core.Col.
+package row
+
+import (
+	"github.com/flanksource/maroto/v2/pkg/core"
+	"github.com/flanksource/maroto/v2/pkg/fpdf"
+	"github.com/flanksource/maroto/v2/pkg/props"
+)
+
+// Row is core.Row plus the presentation props this package's implementation
+// supports, so callers that built one with New can keep chaining without a
+// type assertion back to core.Row.
+type Row interface {
+	core.Row
+	WithFillColor(color props.Color) Row
+	WithLineStyle(style fpdf.LineStyle) Row
+}
+
+type row struct {
+	height    float64
+	cols      []core.Component
+	layerID   *core.LayerID
+	fillColor *props.Color
+	lineStyle *fpdf.LineStyle
+}
+
+// New creates a Row with the given height holding the given components
+// (typically core.Col values).
+func New(height float64, components ...core.Component) Row {
+	return &row{height: height, cols: components}
+}
+
+// WithLayer returns a copy of the row scoped to the given layer: its render
+// is wrapped in the PDF's "/OC BDC … EMC" marked-content sequence.
+func (r *row) WithLayer(id core.LayerID) core.Row {
+	clone := *r
+	clone.layerID = &id
+	return &clone
+}
+
+// WithFillColor returns a copy of the row that fills its background with
+// color (an RGB or, when color.SpotName is set, a spot color) before
+// rendering its columns.
+func (r *row) WithFillColor(color props.Color) Row {
+	clone := *r
+	clone.fillColor = &color
+	return &clone
+}
+
+// WithLineStyle returns a copy of the row whose border/line draws use style
+// (dash pattern, cap, join) while rendering its columns.
+func (r *row) WithLineStyle(style fpdf.LineStyle) Row {
+	clone := *r
+	clone.lineStyle = &style
+	return &clone
+}
+
+// Render draws every column in the row, applying the row's fill color and
+// line style (if any) and scoping them to the row's layer (if any), all via
+// fpdf.DrawingHelper.
+func (r *row) Render(provider core.Provider, cell core.Cell) {
+	cell.Height = r.height
+
+	dh := fpdf.NewDrawingHelper(provider)
+
+	renderCols := func() {
+		if dh != nil && r.fillColor != nil {
+			applyFillColor(dh, *r.fillColor)
+			dh.DrawRect(cell.X, cell.Y, cell.Width, cell.Height, "F")
+		}
+		for _, col := range r.cols {
+			col.Render(provider, cell)
+		}
+	}
+
+	withLineStyle := func(render func()) {
+		if dh == nil || r.lineStyle == nil {
+			render()
+			return
+		}
+		dh.SetDashPattern(r.lineStyle.DashArray, r.lineStyle.DashPhase)
+		if r.lineStyle.Cap != "" {
+			dh.SetLineCapStyle(r.lineStyle.Cap)
+		}
+		if r.lineStyle.Join != "" {
+			dh.SetLineJoinStyle(r.lineStyle.Join)
+		}
+		render()
+		dh.SetDashPattern(nil, 0)
+		dh.SetLineCapStyle(fpdf.LineCapButt)
+		dh.SetLineJoinStyle(fpdf.LineJoinMiter)
+	}
+
+	withLayer := func(render func()) {
+		if dh == nil || r.layerID == nil {
+			render()
+			return
+		}
+		dh.BeginLayer(*r.layerID)
+		render()
+		dh.EndLayer()
+	}
+
+	withLayer(func() { withLineStyle(renderCols) })
+}
+
+// applyFillColor sets dh's fill color from color, preferring its spot color
+// when set.
+func applyFillColor(dh *fpdf.DrawingHelper, color props.Color) {
+	if color.IsSpot() {
+		dh.SetFillSpotColor(color.SpotName, color.Tint)
+		return
+	}
+	dh.SetFillColor(int(color.Red), int(color.Green), int(color.Blue))
+}
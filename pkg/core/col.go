@@ -0,0 +1,10 @@
+package core
+
+// Col is a vertical slice of a Row holding one or more Components.
+type Col interface {
+	Component
+
+	// WithLayer scopes this column's rendered content to the given layer,
+	// wrapping it in the PDF's "/OC BDC … EMC" marked-content sequence.
+	WithLayer(id LayerID) Col
+}
@@ -0,0 +1,13 @@
+package core
+
+// Cell is the box, in the page's current unit, a Component renders itself
+// into.
+type Cell struct {
+	X, Y, Width, Height float64
+}
+
+// Component is anything that can render itself into a Cell using the given
+// Provider: a row, a column, or a leaf component such as text or an image.
+type Component interface {
+	Render(provider Provider, cell Cell)
+}
@@ -0,0 +1,24 @@
+package core
+
+// LayerID identifies an optional content group (OCG) registered with the
+// underlying PDF document via Maroto.AddLayer.
+type LayerID int
+
+// Layer is an optional content group a Row, Col, or Component can be scoped
+// to via WithLayer, letting it be toggled on/off by a PDF viewer (draft
+// overlays, per-language content, optional annotations, ...).
+type Layer struct {
+	ID      LayerID
+	Name    string
+	Visible bool
+}
+
+// Layerable is implemented by leaf components that support being scoped to a
+// layer via WithLayer. Row and Col declare their own typed WithLayer
+// (returning Row/Col, so builder chains keep chaining at that type) rather
+// than embedding Layerable directly — Go forbids embedding two interfaces
+// that redeclare the same method with different signatures.
+type Layerable interface {
+	Component
+	WithLayer(id LayerID) Component
+}
@@ -0,0 +1,17 @@
+package core
+
+// Maroto is the top-level PDF document builder.
+type Maroto interface {
+	// GetProvider returns the rendering backend in use, for advanced drawing
+	// code that needs to drop below the component API (see pkg/fpdf).
+	GetProvider() Provider
+
+	// AddLayer registers a new optional content group with the given name
+	// and initial visibility, returning the LayerID used to scope a Row,
+	// Col, or Component to it via WithLayer.
+	AddLayer(name string, visible bool) LayerID
+
+	// RegisterHeader registers rows to be rendered at the top of every page,
+	// replacing whatever header was previously registered.
+	RegisterHeader(rows ...Row) error
+}
@@ -0,0 +1,9 @@
+package core
+
+// Provider exposes the underlying rendering backend (e.g. gofpdf) that
+// components render themselves against.
+type Provider interface {
+	// GetFpdf returns the backend's raw drawing interface. Callers that need
+	// it (e.g. pkg/fpdf) type-assert it to the concrete interface they wrap.
+	GetFpdf() interface{}
+}
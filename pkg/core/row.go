@@ -0,0 +1,11 @@
+package core
+
+// Row is a horizontal band of the page, made up of one or more Cols,
+// rendered at a fixed height.
+type Row interface {
+	Component
+
+	// WithLayer scopes this row's rendered content to the given layer,
+	// wrapping it in the PDF's "/OC BDC … EMC" marked-content sequence.
+	WithLayer(id LayerID) Row
+}
@@ -0,0 +1,97 @@
+package fpdf
+
+import "math"
+
+// Point is a 2D coordinate in the page's current unit.
+type Point struct {
+	X, Y float64
+}
+
+// DrawCurve draws a single quadratic Bézier curve from (x0, y0) through
+// control point (cx, cy) to (x1, y1). styleStr follows the same "", "D",
+// "F", "F*", "DF", "B", "B*" matrix as DrawPath.
+func (dh *DrawingHelper) DrawCurve(x0, y0, cx, cy, x1, y1 float64, styleStr string) {
+	if dh.fpdf != nil {
+		dh.fpdf.Curve(x0, y0, cx, cy, x1, y1, styleStr)
+	}
+}
+
+// DrawCurveBezierCubic draws a single cubic Bézier curve from (x0, y0)
+// through control points (cx0, cy0) and (cx1, cy1) to (x1, y1).
+func (dh *DrawingHelper) DrawCurveBezierCubic(x0, y0, cx0, cy0, cx1, cy1, x1, y1 float64, styleStr string) {
+	if dh.fpdf != nil {
+		dh.fpdf.CurveBezierCubic(x0, y0, cx0, cy0, cx1, cy1, x1, y1, styleStr)
+	}
+}
+
+// DrawArc draws an elliptical arc centered at (x, y) with radii (rx, ry),
+// sweeping from startAngle to endAngle degrees (0 = 3 o'clock; since page y
+// grows downward, increasing angles run clockwise on the page, so 90 = 6
+// o'clock). It is approximated as a sequence of cubic Béziers, each
+// covering at most 90° of sweep.
+func (dh *DrawingHelper) DrawArc(x, y, rx, ry, startAngle, endAngle float64, styleStr string) {
+	if dh.fpdf == nil {
+		return
+	}
+
+	const maxSegment = 90.0
+	sweep := endAngle - startAngle
+	segments := int(math.Ceil(math.Abs(sweep) / maxSegment))
+	if segments == 0 {
+		return
+	}
+	step := sweep / float64(segments)
+
+	x0 := x + rx*math.Cos(startAngle*math.Pi/180)
+	y0 := y + ry*math.Sin(startAngle*math.Pi/180)
+	dh.fpdf.MoveTo(x0, y0)
+
+	angle := startAngle
+	for i := 0; i < segments; i++ {
+		next := angle + step
+		cx0, cy0, cx1, cy1, ex, ey := arcBezierControlPoints(x, y, rx, ry, angle, next)
+		dh.fpdf.CurveBezierCubicTo(cx0, cy0, cx1, cy1, ex, ey)
+		angle = next
+	}
+
+	dh.fpdf.DrawPath(styleStr)
+}
+
+// arcBezierControlPoints derives the cubic Bézier that approximates the
+// elliptical arc sweep from startAngle to endAngle (both degrees, <= 90°
+// apart) using the standard kappa = (4/3)*tan(Δ/4) control-point formula.
+func arcBezierControlPoints(x, y, rx, ry, startAngle, endAngle float64) (cx0, cy0, cx1, cy1, ex, ey float64) {
+	start := startAngle * math.Pi / 180
+	end := endAngle * math.Pi / 180
+	delta := end - start
+	kappa := (4.0 / 3.0) * math.Tan(delta/4)
+
+	sinStart, cosStart := math.Sin(start), math.Cos(start)
+	sinEnd, cosEnd := math.Sin(end), math.Cos(end)
+
+	cx0 = x + rx*(cosStart-kappa*sinStart)
+	cy0 = y + ry*(sinStart+kappa*cosStart)
+	cx1 = x + rx*(cosEnd+kappa*sinEnd)
+	cy1 = y + ry*(sinEnd-kappa*cosEnd)
+	ex = x + rx*cosEnd
+	ey = y + ry*sinEnd
+	return
+}
+
+// DrawBeziergon draws a closed path made of cubic Bézier segments. points
+// must contain the starting point followed by groups of three points per
+// segment (two control points and an endpoint); the path is closed back to
+// the starting point before the style is applied.
+func (dh *DrawingHelper) DrawBeziergon(points []Point, styleStr string) {
+	if dh.fpdf == nil || len(points) < 4 || (len(points)-1)%3 != 0 {
+		return
+	}
+
+	dh.fpdf.MoveTo(points[0].X, points[0].Y)
+	for i := 1; i < len(points); i += 3 {
+		c0, c1, end := points[i], points[i+1], points[i+2]
+		dh.fpdf.CurveBezierCubicTo(c0.X, c0.Y, c1.X, c1.Y, end.X, end.Y)
+	}
+	dh.fpdf.ClosePath()
+	dh.fpdf.DrawPath(styleStr)
+}
@@ -0,0 +1,63 @@
+package fpdf
+
+import (
+	"math"
+	"testing"
+)
+
+func assertFloat(t *testing.T, name string, got, want float64) {
+	t.Helper()
+	const epsilon = 1e-9
+	if math.Abs(got-want) > epsilon {
+		t.Errorf("%s = %v, want %v", name, got, want)
+	}
+}
+
+func TestArcBezierControlPointsQuarterCircle(t *testing.T) {
+	// kappa = (4/3)*tan(22.5°), the standard constant for approximating a
+	// quarter of a unit circle with a single cubic Bézier.
+	const kappa = 0.5522847498307936
+
+	cx0, cy0, cx1, cy1, ex, ey := arcBezierControlPoints(0, 0, 1, 1, 0, 90)
+
+	assertFloat(t, "cx0", cx0, 1)
+	assertFloat(t, "cy0", cy0, kappa)
+	assertFloat(t, "cx1", cx1, kappa)
+	assertFloat(t, "cy1", cy1, 1)
+	assertFloat(t, "ex", ex, 0)
+	assertFloat(t, "ey", ey, 1)
+}
+
+func TestArcBezierControlPointsHalfCircle(t *testing.T) {
+	const kappa = 4.0 / 3.0
+
+	cx0, cy0, cx1, cy1, ex, ey := arcBezierControlPoints(0, 0, 1, 1, 0, 180)
+
+	assertFloat(t, "cx0", cx0, 1)
+	assertFloat(t, "cy0", cy0, kappa)
+	assertFloat(t, "cx1", cx1, -1)
+	assertFloat(t, "cy1", cy1, kappa)
+	assertFloat(t, "ex", ex, -1)
+	assertFloat(t, "ey", ey, 0)
+}
+
+func TestArcBezierControlPointsZeroSweep(t *testing.T) {
+	cx0, cy0, cx1, cy1, ex, ey := arcBezierControlPoints(5, 10, 2, 3, 0, 0)
+
+	assertFloat(t, "cx0", cx0, 7)
+	assertFloat(t, "cy0", cy0, 10)
+	assertFloat(t, "cx1", cx1, 7)
+	assertFloat(t, "cy1", cy1, 10)
+	assertFloat(t, "ex", ex, 7)
+	assertFloat(t, "ey", ey, 10)
+}
+
+func TestArcBezierControlPointsOffsetCenterAndRadii(t *testing.T) {
+	// A quarter sweep starting at 90° on an ellipse centered away from the
+	// origin: start point should land at (x, y+ry) and end point at
+	// (x-rx, y).
+	_, _, _, _, ex, ey := arcBezierControlPoints(5, 10, 2, 3, 90, 180)
+
+	assertFloat(t, "ex", ex, 3)
+	assertFloat(t, "ey", ey, 10)
+}
@@ -0,0 +1,54 @@
+package fpdf
+
+import "github.com/flanksource/maroto/v2/pkg/core"
+
+// LayerID identifies an optional content group (OCG) registered with the
+// underlying PDF document via AddLayer. It's an alias of core.LayerID so
+// DrawingHelper and core.Row/core.Col/core.Component agree on the same ID
+// space.
+type LayerID = core.LayerID
+
+// AddLayer registers a new optional content group with the given name and
+// initial visibility, returning the LayerID used to scope subsequent draws
+// to it via BeginLayer/EndLayer.
+func (dh *DrawingHelper) AddLayer(name string, visible bool) LayerID {
+	if dh.fpdf == nil {
+		return LayerID(-1)
+	}
+	return LayerID(dh.fpdf.AddLayer(name, visible))
+}
+
+// BeginLayer wraps subsequent drawing in a "/OC /LayerN BDC" marked-content
+// sequence, tagging it as belonging to the given layer. Must be paired with
+// EndLayer.
+func (dh *DrawingHelper) BeginLayer(id LayerID) {
+	if dh.fpdf != nil {
+		dh.fpdf.BeginLayer(int(id))
+	}
+}
+
+// EndLayer closes the marked-content sequence opened by BeginLayer (PDF
+// "EMC" operator).
+func (dh *DrawingHelper) EndLayer() {
+	if dh.fpdf != nil {
+		dh.fpdf.EndLayer()
+	}
+}
+
+// WithLayer runs render with its draws tagged as belonging to the given
+// layer, opening and closing the marked-content scope around it. It is the
+// same BeginLayer/EndLayer scoping that the row and col Render methods
+// (pkg/components/row, pkg/components/col) apply themselves for WithLayer,
+// surfaced here for advanced drawing code that wants to push/pop a layer
+// scope without going through a component at all.
+func WithLayer(maroto core.Maroto, id LayerID, render func()) {
+	dh := NewDrawingHelperFromMaroto(maroto)
+	if dh == nil {
+		render()
+		return
+	}
+
+	dh.BeginLayer(id)
+	render()
+	dh.EndLayer()
+}
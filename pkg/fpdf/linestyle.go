@@ -0,0 +1,86 @@
+package fpdf
+
+import "github.com/flanksource/maroto/v2/pkg/core"
+
+// LineCapStyle controls how the end of a stroked line is rendered.
+type LineCapStyle string
+
+// Supported line cap styles, matching the PDF line cap operator values.
+const (
+	LineCapButt   LineCapStyle = "butt"
+	LineCapRound  LineCapStyle = "round"
+	LineCapSquare LineCapStyle = "square"
+)
+
+// LineJoinStyle controls how the corner between two stroked line segments is
+// rendered.
+type LineJoinStyle string
+
+// Supported line join styles, matching the PDF line join operator values.
+const (
+	LineJoinMiter LineJoinStyle = "miter"
+	LineJoinRound LineJoinStyle = "round"
+	LineJoinBevel LineJoinStyle = "bevel"
+)
+
+// SetDashPattern sets the dash pattern used by subsequent line and border
+// draws. dashArray alternates on/off segment lengths in the current unit;
+// dashPhase offsets where the pattern starts. Pass an empty dashArray to
+// reset to a solid line. The pattern is retained across pages.
+func (dh *DrawingHelper) SetDashPattern(dashArray []float64, dashPhase float64) {
+	if dh.fpdf != nil {
+		dh.fpdf.SetDashPattern(dashArray, dashPhase)
+	}
+}
+
+// SetLineCapStyle sets the cap style applied to the ends of stroked lines.
+func (dh *DrawingHelper) SetLineCapStyle(style LineCapStyle) {
+	if dh.fpdf != nil {
+		dh.fpdf.SetLineCapStyle(string(style))
+	}
+}
+
+// SetLineJoinStyle sets the join style applied to the corners of stroked
+// lines.
+func (dh *DrawingHelper) SetLineJoinStyle(style LineJoinStyle) {
+	if dh.fpdf != nil {
+		dh.fpdf.SetLineJoinStyle(string(style))
+	}
+}
+
+// LineStyle bundles the dash/cap/join controls a border or line-based
+// component exposes as a reusable prop; row.Row and col.Col's WithLineStyle
+// take one directly and apply it in their Render the same way WithLineStyle
+// below does.
+type LineStyle struct {
+	DashArray []float64
+	DashPhase float64
+	Cap       LineCapStyle
+	Join      LineJoinStyle
+}
+
+// WithLineStyle applies style on maroto, runs render, then resets dash, cap,
+// and join back to their repo defaults so none of them leak into draws that
+// follow — the same scoping a border/line component's WithLineStyle prop
+// would need.
+func WithLineStyle(maroto core.Maroto, style LineStyle, render func()) {
+	dh := NewDrawingHelperFromMaroto(maroto)
+	if dh == nil {
+		render()
+		return
+	}
+
+	dh.SetDashPattern(style.DashArray, style.DashPhase)
+	if style.Cap != "" {
+		dh.SetLineCapStyle(style.Cap)
+	}
+	if style.Join != "" {
+		dh.SetLineJoinStyle(style.Join)
+	}
+
+	render()
+
+	dh.SetDashPattern(nil, 0)
+	dh.SetLineCapStyle(LineCapButt)
+	dh.SetLineJoinStyle(LineJoinMiter)
+}
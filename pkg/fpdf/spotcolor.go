@@ -0,0 +1,79 @@
+package fpdf
+
+import "github.com/flanksource/maroto/v2/pkg/core"
+
+// SpotColor names a spot color registered via AddSpotColor together with the
+// tint (0.0-1.0) it should be painted at. props.Color carries the same two
+// fields (SpotName, Tint); row.Row/col.Col's WithFillColor accepts a
+// props.Color directly and applies its spot color through SetFillSpotColor.
+// The Apply*Spot helpers below set a SpotColor on a Maroto instance.
+type SpotColor struct {
+	Name string
+	Tint float64
+}
+
+// AddSpotColor registers a named spot (Separation) color defined by its CMYK
+// tint transform, for use in print-ready output where RGB/CMYK process
+// colors aren't acceptable. name is later referenced by SetFillSpotColor and
+// SetDrawSpotColor.
+func (dh *DrawingHelper) AddSpotColor(name string, c, m, y, k byte) {
+	if dh.fpdf != nil {
+		dh.fpdf.AddSpotColor(name, c, m, y, k)
+	}
+}
+
+// SetFillSpotColor sets the fill color for subsequent drawing operations to
+// a previously registered spot color at the given tint (0.0-1.0).
+func (dh *DrawingHelper) SetFillSpotColor(name string, tint float64) {
+	if dh.fpdf != nil {
+		dh.fpdf.SetFillSpotColor(name, tint)
+	}
+}
+
+// SetDrawSpotColor sets the draw/border color for subsequent drawing
+// operations to a previously registered spot color at the given tint
+// (0.0-1.0).
+func (dh *DrawingHelper) SetDrawSpotColor(name string, tint float64) {
+	if dh.fpdf != nil {
+		dh.fpdf.SetDrawSpotColor(name, tint)
+	}
+}
+
+// SetTextSpotColor sets the text color for subsequent text draws to a
+// previously registered spot color at the given tint (0.0-1.0).
+func (dh *DrawingHelper) SetTextSpotColor(name string, tint float64) {
+	if dh.fpdf != nil {
+		dh.fpdf.SetTextSpotColor(name, tint)
+	}
+}
+
+// AddSpotColorTo is the builder-level equivalent of DrawingHelper's
+// AddSpotColor, matching maroto.AddSpotColor(name, c, m, y, k) from the
+// request.
+func AddSpotColorTo(maroto core.Maroto, name string, c, m, y, k byte) {
+	dh := NewDrawingHelperFromMaroto(maroto)
+	if dh != nil {
+		dh.AddSpotColor(name, c, m, y, k)
+	}
+}
+
+// ApplyFillSpot sets the fill spot color (borders/fills) on maroto from sc.
+func ApplyFillSpot(maroto core.Maroto, sc SpotColor) {
+	if dh := NewDrawingHelperFromMaroto(maroto); dh != nil {
+		dh.SetFillSpotColor(sc.Name, sc.Tint)
+	}
+}
+
+// ApplyDrawSpot sets the draw spot color (borders/lines) on maroto from sc.
+func ApplyDrawSpot(maroto core.Maroto, sc SpotColor) {
+	if dh := NewDrawingHelperFromMaroto(maroto); dh != nil {
+		dh.SetDrawSpotColor(sc.Name, sc.Tint)
+	}
+}
+
+// ApplyTextSpot sets the text spot color on maroto from sc.
+func ApplyTextSpot(maroto core.Maroto, sc SpotColor) {
+	if dh := NewDrawingHelperFromMaroto(maroto); dh != nil {
+		dh.SetTextSpotColor(sc.Name, sc.Tint)
+	}
+}
@@ -0,0 +1,92 @@
+package fpdf
+
+import (
+	"github.com/flanksource/maroto/v2/internal/providers/gofpdf/gofpdfwrapper"
+	"github.com/flanksource/maroto/v2/pkg/core"
+)
+
+// Template is a handle to content captured once as a PDF Form XObject and
+// stamped repeatedly via UseTemplate, instead of being re-rendered on every
+// page it appears on.
+type Template struct {
+	tpl gofpdfwrapper.Template
+}
+
+// CreateTemplate captures everything drawn by render during the call as a
+// single reusable Form XObject. The returned Template can then be stamped
+// onto any page via UseTemplate without re-emitting its content stream.
+//
+// Example usage:
+//
+//	letterhead := drawingHelper.CreateTemplate(func(tpl *fpdf.DrawingHelper) {
+//	    tpl.DrawRect(0, 0, 210, 30, "F")
+//	})
+func (dh *DrawingHelper) CreateTemplate(render func(tpl *DrawingHelper)) *Template {
+	if dh.fpdf == nil {
+		return nil
+	}
+
+	tpl := dh.fpdf.CreateTemplate(func(f gofpdfwrapper.Fpdf) {
+		render(&DrawingHelper{fpdf: f})
+	})
+	return &Template{tpl: tpl}
+}
+
+// UseTemplate stamps a previously captured template into the box at (x, y)
+// sized (w, h), scaling its content to fit.
+func (dh *DrawingHelper) UseTemplate(tpl *Template, x, y, w, h float64) {
+	if dh.fpdf == nil || tpl == nil {
+		return
+	}
+	dh.fpdf.UseTemplateScaled(tpl.tpl, x, y, w, h)
+}
+
+// CreateTemplate is the builder-level equivalent of DrawingHelper's
+// CreateTemplate: it captures render's output against the given Maroto
+// instance as a single reusable Form XObject.
+//
+// Example usage — a letterhead re-used across an invoice's 50+ pages instead
+// of being re-rendered on each one:
+//
+//	letterhead := fpdf.CreateTemplate(maroto, func(tpl *fpdf.DrawingHelper) {
+//	    tpl.DrawRect(0, 0, 210, 30, "F")
+//	})
+func CreateTemplate(maroto core.Maroto, render func(tpl *DrawingHelper)) *Template {
+	dh := NewDrawingHelperFromMaroto(maroto)
+	if dh == nil {
+		return nil
+	}
+	return dh.CreateTemplate(render)
+}
+
+// OnEveryPage registers tpl as a full-page background stamped on every page
+// by wiring it into the builder's real per-page lifecycle: it registers a
+// header row (via Maroto.RegisterHeader) whose Render stamps the template
+// sized to the current page, so an invoice's letterhead/watermark/footer
+// stops being re-rendered on each of its 50+ pages.
+func OnEveryPage(maroto core.Maroto, tpl *Template) error {
+	if tpl == nil {
+		return nil
+	}
+	return maroto.RegisterHeader(&templateRow{tpl: tpl})
+}
+
+// templateRow is the core.Row that OnEveryPage registers as a header: it
+// stamps tpl across the full current page and ignores WithLayer since a
+// repeated background isn't meant to be toggled per-row.
+type templateRow struct {
+	tpl *Template
+}
+
+func (r *templateRow) WithLayer(core.LayerID) core.Row {
+	return r
+}
+
+func (r *templateRow) Render(provider core.Provider, _ core.Cell) {
+	dh := NewDrawingHelper(provider)
+	if dh == nil {
+		return
+	}
+	w, h := dh.GetFpdf().GetPageSize()
+	dh.UseTemplate(r.tpl, 0, 0, w, h)
+}
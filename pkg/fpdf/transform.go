@@ -0,0 +1,160 @@
+package fpdf
+
+import (
+	"math"
+
+	"github.com/flanksource/maroto/v2/internal/providers/gofpdf/gofpdfwrapper"
+	"github.com/flanksource/maroto/v2/pkg/core"
+)
+
+// TransformMatrix represents a 2D affine transformation matrix applied to the
+// current transformation matrix (CTM) via the PDF "cm" operator:
+//
+//	[a b 0]
+//	[c d 0]
+//	[e f 1]
+type TransformMatrix struct {
+	A, B, C, D, E, F float64
+}
+
+// toWrapper converts m to the gofpdfwrapper representation so it can be
+// handed straight to the underlying Fpdf.Transform call.
+func (m TransformMatrix) toWrapper() gofpdfwrapper.TransformMatrix {
+	return gofpdfwrapper.TransformMatrix{A: m.A, B: m.B, C: m.C, D: m.D, E: m.E, F: m.F}
+}
+
+// BeginTransform pushes the current graphics state (PDF "q" operator) so that
+// a subsequent transform only affects drawing performed before the matching
+// EndTransform call.
+func (dh *DrawingHelper) BeginTransform() {
+	if dh.fpdf != nil {
+		dh.fpdf.TransformBegin()
+	}
+}
+
+// EndTransform pops the graphics state pushed by BeginTransform (PDF "Q"
+// operator), restoring the CTM that was active beforehand.
+func (dh *DrawingHelper) EndTransform() {
+	if dh.fpdf != nil {
+		dh.fpdf.TransformEnd()
+	}
+}
+
+// Transform applies an arbitrary affine transformation matrix to the CTM.
+// Must be called between BeginTransform and EndTransform.
+func (dh *DrawingHelper) Transform(m TransformMatrix) {
+	if dh.fpdf != nil {
+		dh.fpdf.Transform(m.toWrapper())
+	}
+}
+
+// Rotate rotates subsequent drawing by angle degrees (counter-clockwise)
+// around the pivot point (x, y).
+func (dh *DrawingHelper) Rotate(angle, x, y float64) {
+	dh.Transform(RotationMatrix(angle, x, y))
+}
+
+// Scale scales subsequent drawing by sx/sy (as fractions, e.g. 1.0 = no
+// change) around the pivot point (x, y).
+func (dh *DrawingHelper) Scale(sx, sy, x, y float64) {
+	dh.Transform(ScaleMatrix(sx, sy, x, y))
+}
+
+// Translate shifts subsequent drawing by (tx, ty).
+func (dh *DrawingHelper) Translate(tx, ty float64) {
+	dh.Transform(TranslateMatrix(tx, ty))
+}
+
+// Skew skews subsequent drawing by ax/ay degrees around the pivot point
+// (x, y).
+func (dh *DrawingHelper) Skew(ax, ay, x, y float64) {
+	dh.Transform(SkewMatrix(ax, ay, x, y))
+}
+
+// MirrorHorizontal mirrors subsequent drawing across the vertical line x.
+func (dh *DrawingHelper) MirrorHorizontal(x float64) {
+	if dh.fpdf != nil {
+		dh.fpdf.TransformMirrorHorizontal(x)
+	}
+}
+
+// MirrorVertical mirrors subsequent drawing across the horizontal line y.
+func (dh *DrawingHelper) MirrorVertical(y float64) {
+	if dh.fpdf != nil {
+		dh.fpdf.TransformMirrorVertical(y)
+	}
+}
+
+// MirrorPoint mirrors subsequent drawing through the point (x, y).
+func (dh *DrawingHelper) MirrorPoint(x, y float64) {
+	if dh.fpdf != nil {
+		dh.fpdf.TransformMirrorPoint(x, y)
+	}
+}
+
+// MirrorLine mirrors subsequent drawing across a line through (x, y) at
+// angle degrees.
+func (dh *DrawingHelper) MirrorLine(angle, x, y float64) {
+	if dh.fpdf != nil {
+		dh.fpdf.TransformMirrorLine(angle, x, y)
+	}
+}
+
+// RotationMatrix derives the standard rotation matrix for angle degrees
+// around the pivot (x, y). Exported so callers composing a transform for
+// WithTransform (e.g. a watermark or diagonal stamp) don't have to re-derive
+// the trigonometry themselves.
+func RotationMatrix(angle, x, y float64) TransformMatrix {
+	rad := angle * math.Pi / 180
+	cos, sin := math.Cos(rad), math.Sin(rad)
+	return TransformMatrix{
+		A: cos, B: sin, C: -sin, D: cos,
+		E: x - x*cos + y*sin,
+		F: y - x*sin - y*cos,
+	}
+}
+
+// ScaleMatrix derives the standard scale-about-a-point matrix for factors
+// sx/sy (1.0 = no change) around the pivot (x, y).
+func ScaleMatrix(sx, sy, x, y float64) TransformMatrix {
+	return TransformMatrix{
+		A: sx, D: sy,
+		E: x * (1 - sx),
+		F: y * (1 - sy),
+	}
+}
+
+// TranslateMatrix derives the matrix that shifts by (tx, ty).
+func TranslateMatrix(tx, ty float64) TransformMatrix {
+	return TransformMatrix{A: 1, D: 1, E: tx, F: ty}
+}
+
+// SkewMatrix derives the standard skew matrix for ax/ay degrees around the
+// pivot (x, y).
+func SkewMatrix(ax, ay, x, y float64) TransformMatrix {
+	tx := math.Tan(ax * math.Pi / 180)
+	ty := math.Tan(ay * math.Pi / 180)
+	return TransformMatrix{
+		A: 1, B: ty, C: tx, D: 1,
+		E: -y * tx,
+		F: -x * ty,
+	}
+}
+
+// WithTransform lets a component callback render a row/col (or any other
+// draw calls) inside a transformed block without dropping to raw fpdf: it
+// opens the graphics state, applies matrix, runs render, and restores the
+// graphics state afterwards. Build matrix with RotationMatrix/ScaleMatrix/
+// TranslateMatrix/SkewMatrix, or compose several by multiplying them.
+func WithTransform(maroto core.Maroto, matrix TransformMatrix, render func()) {
+	dh := NewDrawingHelperFromMaroto(maroto)
+	if dh == nil {
+		render()
+		return
+	}
+
+	dh.BeginTransform()
+	dh.Transform(matrix)
+	render()
+	dh.EndTransform()
+}
@@ -0,0 +1,19 @@
+// Package props holds the value types ("props") components use to
+// configure how they paint themselves — colors, line styles, and similar
+// presentation details shared across text, border, and fill components.
+package props
+
+// Color configures how a component paints its fill/draw operations: either
+// as an RGB process color, or — when SpotName is set — as a named spot
+// (Separation) color at the given Tint (0.0-1.0), for print-ready output
+// where RGB/CMYK process colors aren't acceptable.
+type Color struct {
+	Red, Green, Blue byte
+	SpotName         string
+	Tint             float64
+}
+
+// IsSpot reports whether c names a spot color rather than an RGB one.
+func (c Color) IsSpot() bool {
+	return c.SpotName != ""
+}
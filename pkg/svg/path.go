@@ -0,0 +1,193 @@
+package svg
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/flanksource/maroto/v2/pkg/fpdf"
+)
+
+// renderPath draws the given SVG path `d` attribute data through dh, scaling
+// it from viewBox coordinates into the box (x, y, w, h) while preserving
+// aspect ratio. Supported commands are M/m, L/l, H/h, V/v, C/c, Q/q and Z/z;
+// any other command is ignored.
+func renderPath(dh *fpdf.DrawingHelper, pathData string, viewBox [4]float64, x, y, w, h float64, styleStr string) {
+	if dh == nil {
+		return
+	}
+
+	scaleX, scaleY := 1.0, 1.0
+	if viewBox[2] != 0 {
+		scaleX = w / viewBox[2]
+	}
+	if viewBox[3] != 0 {
+		scaleY = h / viewBox[3]
+	}
+	scale := scaleX
+	if scaleY < scale {
+		scale = scaleY
+	}
+
+	project := func(px, py float64) (float64, float64) {
+		return x + (px-viewBox[0])*scale, y + (py-viewBox[1])*scale
+	}
+
+	cur := struct{ x, y float64 }{}
+	start := cur
+	for _, cmd := range parsePath(pathData) {
+		switch cmd.op {
+		case 'M', 'm':
+			cur.x, cur.y = resolve(cmd.op == 'm', cur.x, cur.y, cmd.args[0], cmd.args[1])
+			start = cur
+			px, py := project(cur.x, cur.y)
+			dh.GetFpdf().MoveTo(px, py)
+		case 'L', 'l':
+			cur.x, cur.y = resolve(cmd.op == 'l', cur.x, cur.y, cmd.args[0], cmd.args[1])
+			px, py := project(cur.x, cur.y)
+			dh.GetFpdf().LineTo(px, py)
+		case 'H', 'h':
+			nx := cmd.args[0]
+			if cmd.op == 'h' {
+				nx = cur.x + nx
+			}
+			cur.x = nx
+			px, py := project(cur.x, cur.y)
+			dh.GetFpdf().LineTo(px, py)
+		case 'V', 'v':
+			ny := cmd.args[0]
+			if cmd.op == 'v' {
+				ny = cur.y + ny
+			}
+			cur.y = ny
+			px, py := project(cur.x, cur.y)
+			dh.GetFpdf().LineTo(px, py)
+		case 'C', 'c':
+			c0x, c0y := resolve(cmd.op == 'c', cur.x, cur.y, cmd.args[0], cmd.args[1])
+			c1x, c1y := resolve(cmd.op == 'c', cur.x, cur.y, cmd.args[2], cmd.args[3])
+			ex, ey := resolve(cmd.op == 'c', cur.x, cur.y, cmd.args[4], cmd.args[5])
+			p0x, p0y := project(c0x, c0y)
+			p1x, p1y := project(c1x, c1y)
+			pex, pey := project(ex, ey)
+			dh.GetFpdf().CurveBezierCubicTo(p0x, p0y, p1x, p1y, pex, pey)
+			cur.x, cur.y = ex, ey
+		case 'Q', 'q':
+			// Path construction only supports cubic segments (CurveBezierCubicTo),
+			// so the quadratic control point is converted to the equivalent pair
+			// of cubic control points: c0 = start + 2/3*(ctrl-start), c1 = end +
+			// 2/3*(ctrl-end).
+			qx, qy := resolve(cmd.op == 'q', cur.x, cur.y, cmd.args[0], cmd.args[1])
+			ex, ey := resolve(cmd.op == 'q', cur.x, cur.y, cmd.args[2], cmd.args[3])
+			c0x, c0y := cur.x+2.0/3.0*(qx-cur.x), cur.y+2.0/3.0*(qy-cur.y)
+			c1x, c1y := ex+2.0/3.0*(qx-ex), ey+2.0/3.0*(qy-ey)
+			p0x, p0y := project(c0x, c0y)
+			p1x, p1y := project(c1x, c1y)
+			pex, pey := project(ex, ey)
+			dh.GetFpdf().CurveBezierCubicTo(p0x, p0y, p1x, p1y, pex, pey)
+			cur.x, cur.y = ex, ey
+		case 'Z', 'z':
+			cur = start
+			dh.GetFpdf().ClosePath()
+		}
+	}
+
+	dh.GetFpdf().DrawPath(styleStr)
+}
+
+// resolve turns a possibly-relative coordinate pair into an absolute one.
+func resolve(relative bool, curX, curY, dx, dy float64) (float64, float64) {
+	if !relative {
+		return dx, dy
+	}
+	return curX + dx, curY + dy
+}
+
+type pathCommand struct {
+	op   rune
+	args []float64
+}
+
+// parsePath tokenizes a minimal subset of SVG path data (M/m, L/l, H/h,
+// V/v, C/c, Q/q, Z/z) into a sequence of commands with their numeric
+// arguments. Per the SVG path grammar, coordinate pairs following an M/m
+// without a repeated command letter are implicit L/l commands, so the
+// active op downgrades from M to L (or m to l) after its first point.
+func parsePath(d string) []pathCommand {
+	var commands []pathCommand
+	var op rune
+	var numArgs int
+
+	argCounts := map[rune]int{
+		'M': 2, 'm': 2,
+		'L': 2, 'l': 2,
+		'H': 1, 'h': 1,
+		'V': 1, 'v': 1,
+		'C': 6, 'c': 6,
+		'Q': 4, 'q': 4,
+		'Z': 0, 'z': 0,
+	}
+
+	i := 0
+	for i < len(d) {
+		r := rune(d[i])
+		switch {
+		case unicode.IsSpace(r) || r == ',':
+			i++
+		case isPathCommandLetter(r):
+			op = r
+			numArgs = argCounts[op]
+			i++
+			if numArgs == 0 {
+				commands = append(commands, pathCommand{op: op})
+			}
+		case unicode.IsLetter(r):
+			// An unsupported command: it and its arguments are skipped.
+			op, numArgs = 0, 0
+			i++
+		default:
+			if numArgs == 0 {
+				// Numbers with no argument-taking command in effect (before the
+				// first command, after Z/z, or after an unsupported command).
+				i++
+				continue
+			}
+			begin := i
+			args := make([]float64, 0, numArgs)
+			for len(args) < numArgs && i < len(d) {
+				for i < len(d) && (unicode.IsSpace(rune(d[i])) || d[i] == ',') {
+					i++
+				}
+				start := i
+				if i < len(d) && (d[i] == '-' || d[i] == '+') {
+					i++
+				}
+				for i < len(d) && (unicode.IsDigit(rune(d[i])) || d[i] == '.') {
+					i++
+				}
+				v, err := strconv.ParseFloat(d[start:i], 64)
+				if err != nil {
+					break
+				}
+				args = append(args, v)
+			}
+			if len(args) != numArgs {
+				if i == begin {
+					i++
+				}
+				break
+			}
+			commands = append(commands, pathCommand{op: op, args: args})
+			switch op {
+			case 'M':
+				op, numArgs = 'L', argCounts['L']
+			case 'm':
+				op, numArgs = 'l', argCounts['l']
+			}
+		}
+	}
+	return commands
+}
+
+func isPathCommandLetter(r rune) bool {
+	return strings.ContainsRune("MmLlHhVvCcQqZz", r)
+}
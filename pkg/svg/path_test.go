@@ -0,0 +1,66 @@
+package svg
+
+import "testing"
+
+func TestParsePathImplicitLineToAfterMoveTo(t *testing.T) {
+	// "M2 2 10 10 18 2Z" is compact path data commonly emitted by icon
+	// exporters: only the first pair after M is a move, the rest are
+	// implicit line-tos.
+	got := parsePath("M2 2 10 10 18 2Z")
+
+	want := []pathCommand{
+		{op: 'M', args: []float64{2, 2}},
+		{op: 'L', args: []float64{10, 10}},
+		{op: 'L', args: []float64{18, 2}},
+		{op: 'Z'},
+	}
+
+	assertCommands(t, got, want)
+}
+
+func TestParsePathImplicitLineToRelative(t *testing.T) {
+	got := parsePath("m0 0 5 5 5 -5z")
+
+	want := []pathCommand{
+		{op: 'm', args: []float64{0, 0}},
+		{op: 'l', args: []float64{5, 5}},
+		{op: 'l', args: []float64{5, -5}},
+		{op: 'z'},
+	}
+
+	assertCommands(t, got, want)
+}
+
+func TestParsePathExplicitCommandsArePreserved(t *testing.T) {
+	got := parsePath("M0 0 L10 0 C10 5 5 10 0 10 Z")
+
+	want := []pathCommand{
+		{op: 'M', args: []float64{0, 0}},
+		{op: 'L', args: []float64{10, 0}},
+		{op: 'C', args: []float64{10, 5, 5, 10, 0, 10}},
+		{op: 'Z'},
+	}
+
+	assertCommands(t, got, want)
+}
+
+func assertCommands(t *testing.T, got, want []pathCommand) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %d commands, want %d: %v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i].op != want[i].op {
+			t.Errorf("command %d: op = %q, want %q", i, got[i].op, want[i].op)
+		}
+		if len(got[i].args) != len(want[i].args) {
+			t.Errorf("command %d: args = %v, want %v", i, got[i].args, want[i].args)
+			continue
+		}
+		for j := range want[i].args {
+			if got[i].args[j] != want[i].args[j] {
+				t.Errorf("command %d arg %d: = %v, want %v", i, j, got[i].args[j], want[i].args[j])
+			}
+		}
+	}
+}
@@ -0,0 +1,196 @@
+// Package svg renders a minimal subset of SVG markup through
+// fpdf.DrawingHelper, letting components embed vector icons/logos without
+// rasterizing them to PNG first. SVG implements core.Component, so
+// NewFromPath/NewFromFile can be added to a Row/Col like any other
+// component, and core.Layerable, so it can be scoped to a layer directly
+// without needing to be wrapped in a Row/Col first.
+package svg
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/flanksource/maroto/v2/pkg/core"
+	"github.com/flanksource/maroto/v2/pkg/fpdf"
+)
+
+// Options describes the subset of SVG presentation attributes SVG
+// understands: the viewBox it should scale from, and the fill/stroke used to
+// paint the path.
+type Options struct {
+	ViewBox     [4]float64
+	Fill        string
+	Stroke      string
+	StrokeWidth float64
+}
+
+// SVG is a parsed SVG path ready to be rendered into a maroto cell.
+type SVG struct {
+	pathData string
+	opts     Options
+	layerID  *core.LayerID
+}
+
+// NewFromPath builds an SVG from raw path `d` attribute data plus the
+// presentation options a <path> element would otherwise carry.
+func NewFromPath(pathData string, opts Options) *SVG {
+	return &SVG{pathData: pathData, opts: opts}
+}
+
+// NewFromFile reads an SVG document from disk and builds an SVG from its
+// root viewBox and first <path> element's d/fill/stroke/stroke-width
+// attributes.
+func NewFromFile(path string) (*SVG, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("svg: read %s: %w", path, err)
+	}
+	return NewFromBytes(data)
+}
+
+// NewFromBytes builds an SVG from the raw bytes of an SVG document.
+func NewFromBytes(data []byte) (*SVG, error) {
+	var doc svgDocument
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("svg: parse document: %w", err)
+	}
+
+	viewBox, err := parseViewBox(doc.ViewBox)
+	if err != nil {
+		return nil, err
+	}
+
+	var path svgPathElem
+	if len(doc.Paths) > 0 {
+		path = doc.Paths[0]
+	}
+
+	strokeWidth, _ := strconv.ParseFloat(path.StrokeWidth, 64)
+	return &SVG{
+		pathData: path.D,
+		opts: Options{
+			ViewBox:     viewBox,
+			Fill:        path.Fill,
+			Stroke:      path.Stroke,
+			StrokeWidth: strokeWidth,
+		},
+	}, nil
+}
+
+// svgDocument is the minimal XML shape NewFromFile/NewFromBytes understand:
+// the root <svg> element's viewBox and its <path> children (only the first
+// is used).
+type svgDocument struct {
+	XMLName xml.Name      `xml:"svg"`
+	ViewBox string        `xml:"viewBox,attr"`
+	Paths   []svgPathElem `xml:"path"`
+}
+
+type svgPathElem struct {
+	D           string `xml:"d,attr"`
+	Fill        string `xml:"fill,attr"`
+	Stroke      string `xml:"stroke,attr"`
+	StrokeWidth string `xml:"stroke-width,attr"`
+}
+
+// parseViewBox parses a viewBox attribute of the form "minX minY width
+// height".
+func parseViewBox(viewBox string) ([4]float64, error) {
+	var result [4]float64
+	if strings.TrimSpace(viewBox) == "" {
+		return result, nil
+	}
+
+	fields := strings.Fields(viewBox)
+	if len(fields) != 4 {
+		return result, fmt.Errorf("svg: viewBox %q must have 4 values", viewBox)
+	}
+	for i, f := range fields {
+		v, err := strconv.ParseFloat(f, 64)
+		if err != nil {
+			return result, fmt.Errorf("svg: viewBox %q: %w", viewBox, err)
+		}
+		result[i] = v
+	}
+	return result, nil
+}
+
+// WithLayer satisfies core.Layerable: it returns a copy of s scoped to the
+// given layer, so its draws are wrapped in the PDF's "/OC BDC … EMC"
+// marked-content sequence.
+func (s *SVG) WithLayer(id core.LayerID) core.Component {
+	clone := *s
+	clone.layerID = &id
+	return &clone
+}
+
+// Render satisfies core.Component: it draws the SVG into cell, scaled from
+// its viewBox while preserving aspect ratio, scoping the draw to its layer
+// (if any).
+func (s *SVG) Render(provider core.Provider, cell core.Cell) {
+	dh := fpdf.NewDrawingHelper(provider)
+	if dh == nil || s == nil {
+		return
+	}
+
+	if s.layerID == nil {
+		s.renderInto(dh, cell.X, cell.Y, cell.Width, cell.Height)
+		return
+	}
+
+	dh.BeginLayer(*s.layerID)
+	s.renderInto(dh, cell.X, cell.Y, cell.Width, cell.Height)
+	dh.EndLayer()
+}
+
+// renderInto draws the SVG into the box at (x, y) sized (w, h) through dh,
+// for callers (such as tests) that already have a DrawingHelper and don't
+// need to go through a core.Provider.
+func (s *SVG) renderInto(dh *fpdf.DrawingHelper, x, y, w, h float64) {
+	if dh == nil || s == nil {
+		return
+	}
+
+	if width := s.opts.StrokeWidth; width > 0 {
+		dh.GetFpdf().SetLineWidth(width)
+	}
+	if rgb, ok := parseHexColor(s.opts.Fill); ok {
+		dh.SetFillColor(rgb[0], rgb[1], rgb[2])
+	}
+	if rgb, ok := parseHexColor(s.opts.Stroke); ok {
+		dh.SetDrawColor(rgb[0], rgb[1], rgb[2])
+	}
+
+	renderPath(dh, s.pathData, s.opts.ViewBox, x, y, w, h, styleFor(s.opts.Fill, s.opts.Stroke))
+}
+
+// styleFor maps SVG fill/stroke presence onto the DrawPath style matrix
+// ("D", "F", "DF").
+func styleFor(fill, stroke string) string {
+	hasFill := fill != "" && fill != "none"
+	hasStroke := stroke != "" && stroke != "none"
+	switch {
+	case hasFill && hasStroke:
+		return "DF"
+	case hasFill:
+		return "F"
+	default:
+		return "D"
+	}
+}
+
+// parseHexColor parses a "#rrggbb" color. ok is false for "none", "", or any
+// other CSS color syntax this minimal subset doesn't support.
+func parseHexColor(color string) (rgb [3]int, ok bool) {
+	if len(color) != 7 || color[0] != '#' {
+		return rgb, false
+	}
+	v, err := strconv.ParseUint(color[1:], 16, 32)
+	if err != nil {
+		return rgb, false
+	}
+	return [3]int{int(v >> 16 & 0xff), int(v >> 8 & 0xff), int(v & 0xff)}, true
+}